@@ -0,0 +1,91 @@
+// Package aggregation recursively verifies N gnark-native PLONK proofs of
+// the plonky2-verifier wrap circuit inside a single outer BN254 circuit,
+// so that many plonky2 proofs can be settled with one on-chain verification.
+package aggregation
+
+import (
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+	stdplonk "github.com/consensys/gnark/std/recursion/plonk"
+)
+
+// InnerProof is one plonky2-verifier PLONK proof to be aggregated, together
+// with the public witness it was produced against.
+type InnerProof struct {
+	Proof         stdplonk.Proof[FR, G1El, G2El]
+	PublicWitness stdplonk.Witness[FR]
+	VerifyingKey  stdplonk.VerifyingKey[FR, G1El, G2El]
+}
+
+// Field/curve aliases for the outer BN254 recursion circuit. The inner
+// plonky2-verifier proofs are themselves BN254 PLONK proofs verified inside
+// a BN254 circuit, so their scalar field FR is emulated rather than native.
+type (
+	FR  = emulated.BN254Fr
+	G1El = stdplonk.G1El
+	G2El = stdplonk.G2El
+	GTEl = stdplonk.GTEl
+)
+
+// Verifier wraps std/recursion/plonk's in-circuit KZG verifier around the
+// existing verifier.VerifyProofWithPublicInputsCircuit wrap proof, so it can
+// be checked inside an outer circuit instead of on-chain.
+type Verifier struct {
+	verifier *stdplonk.Verifier[FR, G1El, G2El, GTEl]
+}
+
+// NewVerifier builds a Verifier for the outer circuit api.
+func NewVerifier(api recursion.API) (*Verifier, error) {
+	v, err := stdplonk.NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return nil, err
+	}
+	return &Verifier{verifier: v}, nil
+}
+
+// AssertProof asserts that proof verifies against vk for publicWitness.
+func (v *Verifier) AssertProof(vk stdplonk.VerifyingKey[FR, G1El, G2El], proof stdplonk.Proof[FR, G1El, G2El], publicWitness stdplonk.Witness[FR]) error {
+	return v.verifier.AssertProof(vk, proof, publicWitness, stdplonk.WithCompleteArithmetic())
+}
+
+// AggregatorCircuit verifies Proofs, a batch of inner plonky2-verifier PLONK
+// proofs, and folds their public inputs into a single MiMC digest, so the
+// outer circuit's own public input set stays constant-size regardless of how
+// many proofs it aggregates.
+type AggregatorCircuit struct {
+	Proofs []InnerProof
+
+	// AccumulatedDigest is the MiMC accumulation of every inner proof's
+	// public inputs, exposed as the sole public input of the outer circuit.
+	// AccumulateDigest computes the matching value outside the circuit.
+	AccumulatedDigest frontend.Variable `gnark:",public"`
+}
+
+func (c *AggregatorCircuit) Define(api frontend.API) error {
+	recursionApi, err := recursion.NewAPI(api)
+	if err != nil {
+		return err
+	}
+
+	verifier, err := NewVerifier(recursionApi)
+	if err != nil {
+		return err
+	}
+
+	hasher, err := mimc.NewMiMC(api)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range c.Proofs {
+		if err := verifier.AssertProof(p.VerifyingKey, p.Proof, p.PublicWitness); err != nil {
+			return err
+		}
+		hasher.Write(p.PublicWitness.Public...)
+	}
+
+	api.AssertIsEqual(c.AccumulatedDigest, hasher.Sum())
+	return nil
+}
@@ -0,0 +1,28 @@
+package aggregation
+
+import (
+	"math/big"
+
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	bn254mimc "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+)
+
+// AccumulateDigest computes, outside the circuit, the same MiMC digest that
+// AggregatorCircuit.Define accumulates over every inner proof's public
+// inputs. Callers use it to build the AccumulatedDigest assignment for the
+// outer circuit's witness.
+func AccumulateDigest(inner []InnerProof) *big.Int {
+	h := bn254mimc.NewMiMC()
+	for _, p := range inner {
+		for _, limb := range p.PublicWitness.Public {
+			var e fr_bn254.Element
+			e.SetBigInt(limb.BigInt(new(big.Int)))
+			limbBytes := e.Bytes()
+			h.Write(limbBytes[:])
+		}
+	}
+
+	var digest fr_bn254.Element
+	digest.SetBytes(h.Sum(nil))
+	return digest.BigInt(new(big.Int))
+}
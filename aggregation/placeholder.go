@@ -0,0 +1,34 @@
+package aggregation
+
+import (
+	"github.com/consensys/gnark/constraint"
+	stdplonk "github.com/consensys/gnark/std/recursion/plonk"
+)
+
+// Placeholder returns an AggregatorCircuit shaped for compilation: n copies
+// of a placeholder inner proof/verifying key/public witness, sized from
+// innerCCS, the constraint system every one of the n inner proofs was
+// compiled against. std/recursion/plonk's verifier needs its internal
+// slices pre-sized to the inner circuit's shape (constraint count, public
+// input count) before frontend.Compile runs; the real per-proof values are
+// only substituted in later, as the witness assignment passed to Prove.
+func Placeholder(innerCCS constraint.ConstraintSystem, n int) (*AggregatorCircuit, error) {
+	proof, err := stdplonk.PlaceholderProof[FR, G1El, G2El](innerCCS)
+	if err != nil {
+		return nil, err
+	}
+	vk, err := stdplonk.PlaceholderVerifyingKey[FR, G1El, G2El](innerCCS)
+	if err != nil {
+		return nil, err
+	}
+	publicWitness, err := stdplonk.PlaceholderWitness[FR](innerCCS)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs := make([]InnerProof, n)
+	for i := range proofs {
+		proofs[i] = InnerProof{Proof: proof, VerifyingKey: vk, PublicWitness: publicWitness}
+	}
+	return &AggregatorCircuit{Proofs: proofs}, nil
+}
@@ -0,0 +1,52 @@
+// Package backend abstracts over the proving system used to wrap the
+// plonky2-verifier circuit, so the CLI can target Groth16 (per-circuit
+// trusted setup) or PLONK (universal KZG setup) behind the same interface.
+package backend
+
+import (
+	"io"
+
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+)
+
+// Backend is implemented by each proving system the prover CLI can target.
+// A Backend is stateful: Setup must be called before Prove and
+// ExportSolidity, and Prove before MarshalProof.
+type Backend interface {
+	// Setup runs the backend's trusted setup (or loads a universal SRS) for
+	// the given compiled circuit. If dummy is true, a non-cryptographically
+	// sound setup is used instead, for fast local iteration; a backend with
+	// no dummy setup (PlonkBackend) returns an error instead.
+	Setup(r1cs constraint.ConstraintSystem, dummy bool) error
+
+	// Prove computes a witness for assignment and produces a proof of r1cs.
+	Prove(r1cs constraint.ConstraintSystem, assignment frontend.Circuit) (witness.Witness, error)
+
+	// ExportSolidity writes a Solidity verifier contract matching the
+	// backend's verifying key to w.
+	ExportSolidity(w io.Writer) error
+
+	// MarshalProof serializes the most recently computed proof into this
+	// backend's on-chain calldata representation.
+	MarshalProof(validPublicWitness witness.Witness) ([]byte, error)
+}
+
+// New returns the Backend registered under name, e.g. "groth16" or "plonk".
+func New(name string, srsPath string) (Backend, error) {
+	switch name {
+	case "", "groth16":
+		return NewGroth16Backend(), nil
+	case "plonk":
+		return NewPlonkBackend(srsPath), nil
+	default:
+		return nil, errUnknownBackend(name)
+	}
+}
+
+type errUnknownBackend string
+
+func (e errUnknownBackend) Error() string {
+	return "unknown backend: " + string(e)
+}
@@ -0,0 +1,122 @@
+package backend
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+)
+
+// Groth16Backend wraps the circuit with a Groth16 proof, requiring a
+// per-circuit trusted setup.
+type Groth16Backend struct {
+	pk    groth16.ProvingKey
+	vk    groth16.VerifyingKey
+	proof groth16.Proof
+}
+
+// NewGroth16Backend returns a Backend that proves with Groth16.
+func NewGroth16Backend() *Groth16Backend {
+	return &Groth16Backend{}
+}
+
+func (b *Groth16Backend) Setup(r1cs constraint.ConstraintSystem, dummy bool) error {
+	var err error
+	if dummy {
+		b.pk, err = groth16.DummySetup(r1cs)
+		return err
+	}
+	b.pk, b.vk, err = groth16.Setup(r1cs)
+	return err
+}
+
+func (b *Groth16Backend) Prove(r1cs constraint.ConstraintSystem, assignment frontend.Circuit) (witness.Witness, error) {
+	fullWitness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, err
+	}
+
+	b.proof, err = groth16.Prove(r1cs, b.pk, fullWitness)
+	if err != nil {
+		return nil, err
+	}
+
+	return fullWitness.Public()
+}
+
+func (b *Groth16Backend) ExportSolidity(w io.Writer) error {
+	return b.vk.ExportSolidity(w)
+}
+
+// MarshalProof packs the Groth16 proof, public inputs and commitment data
+// into the calldata layout expected by the exported Solidity verifier.
+func (b *Groth16Backend) MarshalProof(validPublicWitness witness.Witness) ([]byte, error) {
+	return MarshalGroth16Proof(b.proof, validPublicWitness, b.vk)
+}
+
+// MarshalGroth16Proof packs proof, the public inputs in validPublicWitness,
+// and any commitment data into the calldata layout expected by the Solidity
+// verifier exported for vk. vk may be nil, in which case the commitment
+// count is trusted as-is instead of being cross-checked against it (used by
+// the `prove` subcommand, which only has the proving key on hand).
+func MarshalGroth16Proof(proof groth16.Proof, validPublicWitness witness.Witness, vk groth16.VerifyingKey) ([]byte, error) {
+	nbPublicInputs := len(validPublicWitness.Vector().(fr_bn254.Vector))
+
+	_proof, ok := proof.(interface{ MarshalSolidity() []byte })
+	if !ok {
+		return nil, fmt.Errorf("groth16 proof does not implement MarshalSolidity")
+	}
+	proofBytes := _proof.MarshalSolidity()
+
+	bPublicWitness, err := validPublicWitness.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	// first 4 bytes -> nbPublic, next 4 bytes -> nbSecret, next 4 bytes -> nb elements
+	inputBytes := bPublicWitness[12:]
+
+	const fpSize = 4 * 8
+	if len(inputBytes)%fpSize != 0 {
+		return nil, fmt.Errorf("inputBytes mod 32 != 0")
+	}
+
+	nbInputs := len(inputBytes) / fpSize
+	if nbInputs != nbPublicInputs {
+		return nil, fmt.Errorf("nbInputs != nbPublicInputs")
+	}
+	inputs := make([]string, nbPublicInputs)
+	for i := 0; i < nbInputs; i++ {
+		inputs[i] = "0x" + hex.EncodeToString(inputBytes[fpSize*i:fpSize*(i+1)])
+	}
+
+	proofs := make([]string, 8)
+	for i := 0; i < 8; i++ {
+		proofs[i] = "0x" + hex.EncodeToString(proofBytes[fpSize*i:fpSize*(i+1)])
+	}
+
+	c := new(big.Int).SetBytes(proofBytes[fpSize*8 : fpSize*8+4])
+	commitmentCount := int(c.Int64())
+	if vk != nil {
+		if numOfCommitments := vk.NbPublicWitness() - nbPublicInputs; commitmentCount != numOfCommitments {
+			return nil, fmt.Errorf("commitmentCount != vk.NbCommitments")
+		}
+	}
+
+	commitments := make([]string, 2*commitmentCount)
+	for i := 0; i < 2*commitmentCount; i++ {
+		commitments[i] = "0x" + hex.EncodeToString(proofBytes[fpSize*8+4+i*fpSize:fpSize*8+4+(i+1)*fpSize])
+	}
+
+	commitmentPok := make([]string, 2)
+	commitmentPok[0] = "0x" + hex.EncodeToString(proofBytes[fpSize*8+4+2*commitmentCount*fpSize:fpSize*8+4+2*commitmentCount*fpSize+fpSize])
+	commitmentPok[1] = "0x" + hex.EncodeToString(proofBytes[fpSize*8+4+2*commitmentCount*fpSize+fpSize:fpSize*8+4+2*commitmentCount*fpSize+2*fpSize])
+
+	return marshalGroth16ProofJSON(proofs, inputs, commitments, commitmentPok)
+}
@@ -0,0 +1,158 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/kzg"
+	"github.com/consensys/gnark/backend/plonk"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	stdplonk "github.com/consensys/gnark/std/recursion/plonk"
+	"github.com/succinctlabs/gnark-plonky2-verifier/aggregation"
+)
+
+// PlonkProof is the JSON proof format emitted for the PLONK backend.
+type PlonkProof struct {
+	Proof  string   `json:"proof"`
+	Inputs []string `json:"inputs"`
+}
+
+// PlonkBackend wraps the circuit with a gnark-native PLONK proof, using a
+// universal KZG SRS (e.g. Powers-of-Tau / Aztec ignition) instead of a
+// per-circuit trusted setup.
+type PlonkBackend struct {
+	srsPath string
+
+	srs         kzg.SRS
+	srsLagrange kzg.SRS
+	pk          plonk.ProvingKey
+	vk          plonk.VerifyingKey
+	proof       plonk.Proof
+}
+
+// NewPlonkBackend returns a Backend that proves with PLONK, reading its
+// universal SRS from srsPath in gnark-crypto's binary kzg.SRS encoding (as
+// written by SRS.WriteTo); raw Powers-of-Tau / Aztec ignition transcript
+// files are not parsed.
+func NewPlonkBackend(srsPath string) *PlonkBackend {
+	return &PlonkBackend{srsPath: srsPath}
+}
+
+func (b *PlonkBackend) Setup(r1cs constraint.ConstraintSystem, dummy bool) error {
+	if dummy {
+		return fmt.Errorf("plonk backend does not support dummy setup, pass a real SRS")
+	}
+	if b.srsPath == "" {
+		return fmt.Errorf("plonk backend requires --srs")
+	}
+
+	f, err := os.Open(b.srsPath)
+	if err != nil {
+		return fmt.Errorf("opening SRS file: %w", err)
+	}
+	defer f.Close()
+
+	b.srs = kzg.NewSRS(ecc.BN254)
+	if _, err := b.srs.ReadFrom(f); err != nil {
+		return fmt.Errorf("reading SRS: %w", err)
+	}
+
+	b.srsLagrange, err = lagrangeSRS(b.srs, r1cs)
+	if err != nil {
+		return fmt.Errorf("deriving Lagrange-basis SRS: %w", err)
+	}
+
+	b.pk, b.vk, err = plonk.Setup(r1cs, b.srs, b.srsLagrange)
+	return err
+}
+
+// lagrangeSRS derives the Lagrange-basis SRS plonk.Setup needs alongside
+// the monomial-basis one read from --srs, by inverse-FFT-ing its G1 points
+// over the domain sized to r1cs (the same sizing plonk.Setup itself uses
+// internally). This is the real, file-backed-SRS equivalent of the
+// conversion gnark's unsafekzg test helper applies to a freshly generated
+// SRS.
+func lagrangeSRS(srs kzg.SRS, r1cs constraint.ConstraintSystem) (kzg.SRS, error) {
+	domain := fft.NewDomain(uint64(r1cs.GetNbConstraints() + r1cs.GetNbPublicVariables()))
+
+	lagrangeG1, err := kzg.ToLagrangeG1(srs.Pk.G1, domain)
+	if err != nil {
+		return kzg.SRS{}, err
+	}
+
+	return kzg.SRS{
+		Pk: kzg.ProvingKey{G1: lagrangeG1},
+		Vk: srs.Vk,
+	}, nil
+}
+
+func (b *PlonkBackend) Prove(r1cs constraint.ConstraintSystem, assignment frontend.Circuit) (witness.Witness, error) {
+	fullWitness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, err
+	}
+
+	b.proof, err = plonk.Prove(r1cs, b.pk, fullWitness)
+	if err != nil {
+		return nil, err
+	}
+
+	return fullWitness.Public()
+}
+
+func (b *PlonkBackend) ExportSolidity(w io.Writer) error {
+	return b.vk.ExportSolidity(w)
+}
+
+// MarshalProof serializes the PLONK proof and public inputs to JSON.
+func (b *PlonkBackend) MarshalProof(validPublicWitness witness.Witness) ([]byte, error) {
+	proofBytes := b.proof.MarshalSolidity()
+
+	bPublicWitness, err := validPublicWitness.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	inputBytes := bPublicWitness[12:]
+
+	const fpSize = 4 * 8
+	nbInputs := len(inputBytes) / fpSize
+	inputs := make([]string, nbInputs)
+	for i := 0; i < nbInputs; i++ {
+		inputs[i] = fmt.Sprintf("0x%x", inputBytes[fpSize*i:fpSize*(i+1)])
+	}
+
+	return json.MarshalIndent(PlonkProof{
+		Proof:  fmt.Sprintf("0x%x", proofBytes),
+		Inputs: inputs,
+	}, "", "  ")
+}
+
+// InnerProof converts the most recently computed PLONK proof, verifying key
+// and public witness into the recursion-friendly representation consumed by
+// aggregation.AggregatorCircuit as one of its inner proofs.
+func (b *PlonkBackend) InnerProof(validPublicWitness witness.Witness) (aggregation.InnerProof, error) {
+	proof, err := stdplonk.ValueOfProof[aggregation.FR, aggregation.G1El, aggregation.G2El](b.proof)
+	if err != nil {
+		return aggregation.InnerProof{}, err
+	}
+	vk, err := stdplonk.ValueOfVerifyingKey[aggregation.FR, aggregation.G1El, aggregation.G2El](b.vk)
+	if err != nil {
+		return aggregation.InnerProof{}, err
+	}
+	publicWitness, err := stdplonk.ValueOfWitness[aggregation.FR](validPublicWitness)
+	if err != nil {
+		return aggregation.InnerProof{}, err
+	}
+
+	return aggregation.InnerProof{
+		Proof:         proof,
+		VerifyingKey:  vk,
+		PublicWitness: publicWitness,
+	}, nil
+}
@@ -0,0 +1,21 @@
+package backend
+
+import "encoding/json"
+
+// Groth16Proof is the bespoke JSON proof format consumed by the Solidity
+// verifier generated for the Groth16 backend.
+type Groth16Proof struct {
+	Proof         []string `json:"proof"`
+	Inputs        []string `json:"inputs"`
+	Commitments   []string `json:"commitments"`
+	CommitmentPok []string `json:"commitment_pok"`
+}
+
+func marshalGroth16ProofJSON(proof, inputs, commitments, commitmentPok []string) ([]byte, error) {
+	return json.MarshalIndent(Groth16Proof{
+		Proof:         proof,
+		Inputs:        inputs,
+		Commitments:   commitments,
+		CommitmentPok: commitmentPok,
+	}, "", "  ")
+}
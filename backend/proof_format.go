@@ -0,0 +1,95 @@
+package backend
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/witness"
+)
+
+// ProofFormat selects how a Groth16 proof is serialized to disk.
+type ProofFormat string
+
+const (
+	// ProofFormatJSON is the bespoke hex-string JSON consumed by this
+	// repo's own Solidity verifier contract.
+	ProofFormatJSON ProofFormat = "json"
+	// ProofFormatGnark is gnark's native binary encoding, written via
+	// groth16.Proof.WriteTo, for consumption by another gnark program.
+	ProofFormatGnark ProofFormat = "gnark"
+	// ProofFormatBellman is the [nbPublic nbSecret][n|elements] witness
+	// prefix followed by the raw Ar/Bs/Krs proof elements, the layout used
+	// by bellman/zcash-style verifiers (which don't know about gnark's
+	// Pedersen-commitment extension).
+	ProofFormatBellman ProofFormat = "bellman"
+)
+
+// WriteProof serializes proof and validPublicWitness in the given format.
+func WriteProof(format ProofFormat, proof groth16.Proof, validPublicWitness witness.Witness) ([]byte, error) {
+	switch format {
+	case "", ProofFormatJSON:
+		return MarshalGroth16Proof(proof, validPublicWitness, nil)
+	case ProofFormatGnark:
+		return marshalGnarkProof(proof, validPublicWitness)
+	case ProofFormatBellman:
+		return marshalBellmanProof(proof, validPublicWitness)
+	default:
+		return nil, fmt.Errorf("unknown proof format: %s", format)
+	}
+}
+
+// marshalGnarkProof concatenates the proof's and the public witness' native
+// gnark binary encodings: a 4-byte big-endian length prefix, then
+// proof.WriteTo, then validPublicWitness.WriteTo.
+func marshalGnarkProof(proof groth16.Proof, validPublicWitness witness.Witness) ([]byte, error) {
+	var proofBuf bytes.Buffer
+	if _, err := proof.WriteTo(&proofBuf); err != nil {
+		return nil, fmt.Errorf("writing proof: %w", err)
+	}
+
+	witnessBytes, err := validPublicWitness.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshalling public witness: %w", err)
+	}
+
+	var out bytes.Buffer
+	writeUint32(&out, uint32(proofBuf.Len()))
+	out.Write(proofBuf.Bytes())
+	out.Write(witnessBytes)
+	return out.Bytes(), nil
+}
+
+// marshalBellmanProof emits the witness prefix used by bellman/zcash-style
+// verifiers ([nbPublic nbSecret][n|elements]) followed by the raw
+// Ar/Bs/Krs proof elements, dropping gnark's Pedersen-commitment extension
+// which those verifiers don't understand.
+func marshalBellmanProof(proof groth16.Proof, validPublicWitness witness.Witness) ([]byte, error) {
+	_proof, ok := proof.(interface{ MarshalSolidity() []byte })
+	if !ok {
+		return nil, fmt.Errorf("groth16 proof does not implement MarshalSolidity")
+	}
+	proofBytes := _proof.MarshalSolidity()
+
+	const fpSize = 4 * 8
+	if len(proofBytes) < fpSize*8 {
+		return nil, fmt.Errorf("proof too short for bellman layout")
+	}
+
+	bPublicWitness, err := validPublicWitness.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	out.Write(bPublicWitness) // [nbPublic nbSecret][n|elements]
+	out.Write(proofBytes[:fpSize*8]) // Ar, Bs, Krs only
+	return out.Bytes(), nil
+}
+
+func writeUint32(buf *bytes.Buffer, v uint32) {
+	buf.WriteByte(byte(v >> 24))
+	buf.WriteByte(byte(v >> 16))
+	buf.WriteByte(byte(v >> 8))
+	buf.WriteByte(byte(v))
+}
@@ -0,0 +1,69 @@
+// Package circuit builds verifier.ExampleVerifierCircuit from a wrapped
+// plonky2 proof dir, shared by every CLI entry point (the Groth16 prover,
+// the PLONK prover, and the aggregator) so the read/compile logic lives in
+// one place instead of being copy-pasted per binary.
+package circuit
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/profile"
+	"github.com/succinctlabs/gnark-plonky2-verifier/types"
+	"github.com/succinctlabs/gnark-plonky2-verifier/variables"
+	"github.com/succinctlabs/gnark-plonky2-verifier/verifier"
+)
+
+// Build reads the wrapped proof and circuit data from inDir and compiles
+// verifier.ExampleVerifierCircuit to a constraint system.
+func Build(inDir string, profileCircuit bool) (constraint.ConstraintSystem, error) {
+	commonCircuitData := types.ReadCommonCircuitData(inDir + "/common_circuit_data.json")
+	proofWithPis := variables.DeserializeProofWithPublicInputs(types.ReadProofWithPublicInputs(inDir + "/proof_with_public_inputs.json"))
+	verifierOnlyCircuitData := variables.DeserializeVerifierOnlyCircuitData(types.ReadVerifierOnlyCircuitData(inDir + "/verifier_only_circuit_data.json"))
+
+	circuit := verifier.ExampleVerifierCircuit{
+		Proof:                   proofWithPis.Proof,
+		PublicInputs:            proofWithPis.PublicInputs,
+		VerifierOnlyCircuitData: verifierOnlyCircuitData,
+		CommonCircuitData:       commonCircuitData,
+	}
+
+	var p *profile.Profile
+	if profileCircuit {
+		p = profile.Start()
+	}
+
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
+	if err != nil {
+		return nil, fmt.Errorf("compiling circuit: %w", err)
+	}
+
+	if profileCircuit {
+		p.Stop()
+		p.Top()
+		println("ccs.GetNbCoefficients(): ", ccs.GetNbCoefficients())
+		println("ccs.GetNbConstraints(): ", ccs.GetNbConstraints())
+		println("ccs.GetNbSecretVariables(): ", ccs.GetNbSecretVariables())
+		println("ccs.GetNbPublicVariables(): ", ccs.GetNbPublicVariables())
+		println("ccs.GetNbInternalVariables(): ", ccs.GetNbInternalVariables())
+	}
+
+	return ccs, nil
+}
+
+// Assignment reads the wrapped proof from inDir and returns the circuit
+// assignment used to prove it (CommonCircuitData is only needed at compile
+// time, so it's omitted here, matching Build's caller-facing contract).
+func Assignment(inDir string) (*verifier.ExampleVerifierCircuit, error) {
+	proofWithPis := variables.DeserializeProofWithPublicInputs(types.ReadProofWithPublicInputs(inDir + "/proof_with_public_inputs.json"))
+	verifierOnlyCircuitData := variables.DeserializeVerifierOnlyCircuitData(types.ReadVerifierOnlyCircuitData(inDir + "/verifier_only_circuit_data.json"))
+
+	return &verifier.ExampleVerifierCircuit{
+		Proof:                   proofWithPis.Proof,
+		PublicInputs:            proofWithPis.PublicInputs,
+		VerifierOnlyCircuitData: verifierOnlyCircuitData,
+	}, nil
+}
@@ -0,0 +1,54 @@
+// Aggregate CLI: proves N plonky2-verifier wrap circuits with PLONK, then
+// recursively verifies all N proofs inside one outer BN254 circuit, so a
+// caller only has to settle a single aggregated proof on-chain.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/consensys/gnark/constraint"
+	"github.com/succinctlabs/gnark-plonky2-verifier/aggregation"
+)
+
+// Main entry point
+func main() {
+	inDirsFlag := flag.String("in-dirs", "", "Comma-separated list of wrapped-proof input dirs, one per inner proof")
+	outProof := flag.String("out-proof", "", "Output aggregated proof file path")
+	srsPath := flag.String("srs", "", "Path to the universal KZG SRS shared by every inner and the outer proof")
+
+	flag.Parse()
+
+	if *inDirsFlag == "" || *outProof == "" || *srsPath == "" {
+		fmt.Println("in-dirs, out-proof and srs are required")
+		os.Exit(1)
+	}
+	inDirs := strings.Split(*inDirsFlag, ",")
+
+	fmt.Printf("Aggregating %d inner proofs into %s\n", len(inDirs), *outProof)
+
+	if err := runAggregate(inDirs, *srsPath, *outProof); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+// runAggregate proves each inner dir's wrap circuit with PLONK, assembles an
+// aggregation.AggregatorCircuit over the resulting proofs, and proves that
+// outer circuit, writing the result to outProof.
+func runAggregate(inDirs []string, srsPath string, outProof string) error {
+	inner := make([]aggregation.InnerProof, 0, len(inDirs))
+	var innerCCS constraint.ConstraintSystem
+	for _, dir := range inDirs {
+		proof, ccs, err := proveInner(dir, srsPath)
+		if err != nil {
+			return fmt.Errorf("proving inner proof from %s: %w", dir, err)
+		}
+		inner = append(inner, proof)
+		innerCCS = ccs
+	}
+
+	return proveOuter(inner, innerCCS, srsPath, outProof)
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/succinctlabs/gnark-plonky2-verifier/aggregation"
+	gnarkbackend "github.com/succinctlabs/gnark-plonky2-verifier/backend"
+	"github.com/succinctlabs/gnark-plonky2-verifier/circuit"
+)
+
+// compilePlaceholder compiles the outer aggregation circuit from its
+// placeholder-shaped template.
+func compilePlaceholder(placeholder *aggregation.AggregatorCircuit) (constraint.ConstraintSystem, error) {
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, placeholder)
+	if err != nil {
+		return nil, fmt.Errorf("compiling aggregator circuit: %w", err)
+	}
+	return ccs, nil
+}
+
+// proveInner compiles and PLONK-proves the wrap circuit for a single
+// plonky2 proof, returning it in the shape aggregation.AggregatorCircuit
+// expects as one of its inner proofs, along with the inner constraint
+// system it was compiled against (every inner proof in a batch must share
+// the same shape, since the outer circuit is built from one of them).
+func proveInner(inDir string, srsPath string) (aggregation.InnerProof, constraint.ConstraintSystem, error) {
+	ccs, err := circuit.Build(inDir, false)
+	if err != nil {
+		return aggregation.InnerProof{}, nil, err
+	}
+
+	b := gnarkbackend.NewPlonkBackend(srsPath)
+	if err := b.Setup(ccs, false); err != nil {
+		return aggregation.InnerProof{}, nil, err
+	}
+
+	assignment, err := circuit.Assignment(inDir)
+	if err != nil {
+		return aggregation.InnerProof{}, nil, err
+	}
+	publicWitness, err := b.Prove(ccs, assignment)
+	if err != nil {
+		return aggregation.InnerProof{}, nil, err
+	}
+
+	inner, err := b.InnerProof(publicWitness)
+	return inner, ccs, err
+}
+
+// proveOuter compiles aggregation.AggregatorCircuit, shaped with
+// aggregation.Placeholder from innerCCS (the constraint system the inner
+// proofs were compiled against), PLONK-proves it over inner against the
+// same SRS used for the inner proofs, and writes the result to outProof.
+func proveOuter(inner []aggregation.InnerProof, innerCCS constraint.ConstraintSystem, srsPath string, outProof string) error {
+	placeholder, err := aggregation.Placeholder(innerCCS, len(inner))
+	if err != nil {
+		return err
+	}
+
+	b := gnarkbackend.NewPlonkBackend(srsPath)
+	ccs, err := compilePlaceholder(placeholder)
+	if err != nil {
+		return err
+	}
+	if err := b.Setup(ccs, false); err != nil {
+		return err
+	}
+
+	assignment := &aggregation.AggregatorCircuit{
+		Proofs:            inner,
+		AccumulatedDigest: aggregation.AccumulateDigest(inner),
+	}
+	publicWitness, err := b.Prove(ccs, assignment)
+	if err != nil {
+		return err
+	}
+
+	proofBytes, err := b.MarshalProof(publicWitness)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(outProof, proofBytes, 0644)
+}
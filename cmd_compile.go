@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/succinctlabs/gnark-plonky2-verifier/circuit"
+)
+
+// runCompile builds the verifier circuit for the wrapped proof in in-dir and
+// serializes the resulting R1CS to out-r1cs, so later subcommands don't have
+// to recompile it.
+func runCompile(args []string) error {
+	fs := flag.NewFlagSet("compile", flag.ExitOnError)
+	inDir := fs.String("in-dir", "", "Input wrapped proof dir path")
+	outR1CS := fs.String("out-r1cs", "", "Output serialized R1CS file path")
+	profileCircuit := fs.Bool("profile", false, "Profile the circuit")
+	fs.Parse(args)
+
+	if *inDir == "" || *outR1CS == "" {
+		return fmt.Errorf("compile: --in-dir and --out-r1cs are required")
+	}
+
+	fmt.Println("Compiling circuit")
+	ccs, err := circuit.Build(*inDir, *profileCircuit)
+	if err != nil {
+		return err
+	}
+
+	if err := writeTo(*outR1CS, ccs); err != nil {
+		return fmt.Errorf("writing R1CS: %w", err)
+	}
+
+	fmt.Printf("Wrote R1CS to %s\n", *outR1CS)
+	return nil
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+// runExportSolidity regenerates the Solidity verifier contract from a
+// previously saved verifying key.
+func runExportSolidity(args []string) error {
+	fs := flag.NewFlagSet("export-solidity", flag.ExitOnError)
+	inVK := fs.String("in-vk", "", "Input verifying key file path")
+	outContract := fs.String("out-contract", "", "Output Solidity contract file path")
+	fs.Parse(args)
+
+	if *inVK == "" || *outContract == "" {
+		return fmt.Errorf("export-solidity: --in-vk and --out-contract are required")
+	}
+
+	vk, err := readVK(*inVK)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(*outContract)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", *outContract, err)
+	}
+	defer f.Close()
+
+	if err := vk.ExportSolidity(f); err != nil {
+		return fmt.Errorf("exporting solidity: %w", err)
+	}
+
+	fmt.Printf("Wrote Solidity contract to %s\n", *outContract)
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/witness"
+	gnarkbackend "github.com/succinctlabs/gnark-plonky2-verifier/backend"
+)
+
+// runProve loads a previously compiled R1CS and proving key, obtains a
+// witness (building it from --in-dir, or loading it from --in-witness /
+// --in-witness-json if one was precomputed), and writes a Groth16 proof.
+func runProve(args []string) error {
+	fs := flag.NewFlagSet("prove", flag.ExitOnError)
+	inR1CS := fs.String("in-r1cs", "", "Input serialized R1CS file path")
+	inPK := fs.String("in-pk", "", "Input proving key file path")
+	inDir := fs.String("in-dir", "", "Input wrapped proof dir path; builds the witness if no --in-witness* is given")
+	inWitness := fs.String("in-witness", "", "Input precomputed witness file path, gnark-standard binary layout")
+	inWitnessJSON := fs.String("in-witness-json", "", "Input precomputed witness file path, schema-aware JSON")
+	outProof := fs.String("out-proof", "", "Output proof file path")
+	proofFormat := fs.String("proof-format", "json", "Proof serialization: json, gnark or bellman")
+	fs.Parse(args)
+
+	if *inR1CS == "" || *inPK == "" || *outProof == "" {
+		return fmt.Errorf("prove: --in-r1cs, --in-pk and --out-proof are required")
+	}
+
+	ccs, err := readR1CS(*inR1CS)
+	if err != nil {
+		return err
+	}
+	pk, err := readPK(*inPK)
+	if err != nil {
+		return err
+	}
+
+	fullWitness, err := resolveWitness(*inDir, *inWitness, *inWitnessJSON)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Creating proof")
+	proof, err := groth16.Prove(ccs, pk, fullWitness)
+	if err != nil {
+		return fmt.Errorf("proving: %w", err)
+	}
+
+	validPublicWitness, err := fullWitness.Public()
+	if err != nil {
+		return err
+	}
+
+	proofBytes, err := gnarkbackend.WriteProof(gnarkbackend.ProofFormat(*proofFormat), proof, validPublicWitness)
+	if err != nil {
+		return fmt.Errorf("marshalling proof: %w", err)
+	}
+
+	if err := os.WriteFile(*outProof, proofBytes, 0644); err != nil {
+		return fmt.Errorf("writing proof: %w", err)
+	}
+
+	fmt.Printf("Wrote proof to %s\n", *outProof)
+	return nil
+}
+
+// resolveWitness returns the witness to prove with, preferring a
+// precomputed one (--in-witness/--in-witness-json) and falling back to
+// building it from the wrapped proof dir. --in-witness-json additionally
+// needs --in-dir, since its JSON schema is reconstructed from the wrapped
+// proof's shape rather than stored alongside the witness itself.
+func resolveWitness(inDir, inWitness, inWitnessJSON string) (witness.Witness, error) {
+	switch {
+	case inWitness != "":
+		fmt.Println("Loading precomputed witness")
+		return readWitnessFile(inWitness)
+	case inWitnessJSON != "":
+		if inDir == "" {
+			return nil, fmt.Errorf("--in-witness-json also requires --in-dir, to rebuild the schema it was marshalled with")
+		}
+		fmt.Println("Loading precomputed witness JSON")
+		return readWitnessJSONFile(inWitnessJSON, inDir)
+	case inDir != "":
+		fmt.Println("Generating witness")
+		return BuildWitness(inDir)
+	default:
+		return nil, fmt.Errorf("one of --in-dir, --in-witness or --in-witness-json is required")
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/constraint"
+	cs_bn254 "github.com/consensys/gnark/constraint/bn254"
+)
+
+// runSetup runs the Groth16 dummy setup for fast local iteration. It
+// deliberately refuses to run a real, single-party setup: production keys
+// must come from setup-init/setup-contribute/setup-finalize instead, so no
+// single party is trusted with the ceremony's toxic waste.
+func runSetup(args []string) error {
+	fs := flag.NewFlagSet("setup", flag.ExitOnError)
+	inR1CS := fs.String("in-r1cs", "", "Input serialized R1CS file path")
+	outPK := fs.String("out-pk", "", "Output proving key file path")
+	dummySetup := fs.Bool("dummy", false, "Use the dummy setup")
+	fs.Parse(args)
+
+	if !*dummySetup {
+		return fmt.Errorf("setup: real setup must go through setup-init/setup-contribute/setup-finalize; pass --dummy for local iteration")
+	}
+	if *inR1CS == "" || *outPK == "" {
+		return fmt.Errorf("setup: --in-r1cs and --out-pk are required")
+	}
+
+	ccs, err := readR1CS(*inR1CS)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Using dummy setup")
+	pk, err := groth16.DummySetup(ccs)
+	if err != nil {
+		return fmt.Errorf("running setup: %w", err)
+	}
+
+	if err := writeTo(*outPK, pk); err != nil {
+		return fmt.Errorf("writing proving key: %w", err)
+	}
+	fmt.Printf("Wrote proving key to %s\n", *outPK)
+	return nil
+}
+
+// readR1CS deserializes a constraint.ConstraintSystem previously written by
+// the compile subcommand.
+func readR1CS(path string) (constraint.ConstraintSystem, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	ccs := cs_bn254.NewR1CS(0)
+	if _, err := ccs.ReadFrom(f); err != nil {
+		return nil, fmt.Errorf("reading R1CS: %w", err)
+	}
+	return ccs, nil
+}
+
+// readPK deserializes a Groth16 proving key previously written by setup.
+func readPK(path string) (groth16.ProvingKey, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	pk := groth16.NewProvingKey(ecc.BN254)
+	if _, err := pk.ReadFrom(f); err != nil {
+		return nil, fmt.Errorf("reading proving key: %w", err)
+	}
+	return pk, nil
+}
+
+// readVK deserializes a Groth16 verifying key previously written by
+// setup-finalize.
+func readVK(path string) (groth16.VerifyingKey, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	vk := groth16.NewVerifyingKey(ecc.BN254)
+	if _, err := vk.ReadFrom(f); err != nil {
+		return nil, fmt.Errorf("reading verifying key: %w", err)
+	}
+	return vk, nil
+}
+
+// writeTo serializes v (a proving/verifying key, or an R1CS) to path.
+func writeTo(path string, v io.WriterTo) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = v.WriteTo(f)
+	return err
+}
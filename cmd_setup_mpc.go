@@ -0,0 +1,223 @@
+package main
+
+import (
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/consensys/gnark/backend/groth16"
+)
+
+// runSetupInit starts a new Groth16 setup ceremony, binding a fresh
+// transcript to a Powers-of-Tau / Aztec ignition file. The PoT file's bytes
+// are stored as the transcript's Data and their hash is the genesis round's
+// hash; the transcript only grows via setup-contribute rounds.
+func runSetupInit(args []string) error {
+	fs := flag.NewFlagSet("setup-init", flag.ExitOnError)
+	inPOT := fs.String("in-pot", "", "Input Powers-of-Tau / Aztec ignition file path")
+	outTranscript := fs.String("out-transcript", "", "Output phase-1 transcript file path")
+	fs.Parse(args)
+
+	if *inPOT == "" || *outTranscript == "" {
+		return fmt.Errorf("setup-init: --in-pot and --out-transcript are required")
+	}
+
+	pot, err := os.ReadFile(*inPOT)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", *inPOT, err)
+	}
+
+	genesisHash, err := hashState("", pot)
+	if err != nil {
+		return err
+	}
+
+	t := &Transcript{
+		Phase: 1,
+		Data:  pot,
+		Contributions: []Contribution{{
+			Round: 0,
+			Hash:  genesisHash,
+			Time:  time.Now().UTC(),
+		}},
+	}
+
+	if err := writeTranscript(*outTranscript, t); err != nil {
+		return fmt.Errorf("writing transcript: %w", err)
+	}
+
+	fmt.Printf("Initialized ceremony bound to %s, genesis hash %s\n", *inPOT, genesisHash)
+	return nil
+}
+
+// runSetupContribute adds one participant's contribution to a ceremony
+// transcript. The contributor's entropy (either --entropy-file or fresh
+// CSPRNG entropy) is written to --out-secret, a file the contributor keeps
+// to themselves — it must never be copied alongside --out-transcript. Only
+// a one-way commitment to that entropy is chained into the transcript's
+// BLAKE2b hash chain, so anyone holding just the transcript can audit that
+// a contribution happened without being able to recover what it was.
+func runSetupContribute(args []string) error {
+	fs := flag.NewFlagSet("setup-contribute", flag.ExitOnError)
+	inTranscript := fs.String("in-transcript", "", "Input transcript file path")
+	outTranscript := fs.String("out-transcript", "", "Output transcript file path")
+	outSecret := fs.String("out-secret", "", "Output file for this contribution's raw entropy; keep private, never share with --out-transcript")
+	contributor := fs.String("contributor", "", "Name or identifier of the contributing party")
+	entropyFile := fs.String("entropy-file", "", "Optional file of random bytes to use; CSPRNG entropy is generated if omitted")
+	fs.Parse(args)
+
+	if *inTranscript == "" || *outTranscript == "" || *outSecret == "" {
+		return fmt.Errorf("setup-contribute: --in-transcript, --out-transcript and --out-secret are required")
+	}
+
+	t, err := readTranscript(*inTranscript)
+	if err != nil {
+		return err
+	}
+
+	var entropy []byte
+	if *entropyFile != "" {
+		entropy, err = os.ReadFile(*entropyFile)
+		if err != nil {
+			return fmt.Errorf("reading entropy file: %w", err)
+		}
+	} else {
+		entropy, err = randomEntropy()
+		if err != nil {
+			return fmt.Errorf("generating entropy: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(*outSecret, entropy, 0600); err != nil {
+		return fmt.Errorf("writing secret entropy file: %w", err)
+	}
+
+	commitment, err := commitEntropy(entropy)
+	if err != nil {
+		return err
+	}
+
+	prevHash := t.lastHash()
+	newHash, err := hashState(prevHash, []byte(commitment))
+	if err != nil {
+		return err
+	}
+
+	t.Contributions = append(t.Contributions, Contribution{
+		Round:       len(t.Contributions),
+		PrevHash:    prevHash,
+		Commitment:  commitment,
+		Hash:        newHash,
+		Contributor: *contributor,
+		Time:        time.Now().UTC(),
+	})
+
+	if err := writeTranscript(*outTranscript, t); err != nil {
+		return fmt.Errorf("writing transcript: %w", err)
+	}
+
+	fmt.Printf("Contribution #%d by %q recorded, commitment %s\n", len(t.Contributions)-1, *contributor, commitment)
+	fmt.Printf("Wrote private entropy to %s; keep it off any channel that also carries %s\n", *outSecret, *outTranscript)
+	return nil
+}
+
+// runSetupFinalize closes a ceremony, deriving the Groth16 proving and
+// verifying keys for a compiled R1CS from the final transcript. It needs
+// every contributor's --out-secret file, in contribution order, gathered
+// out-of-band from the contributors themselves — these are never part of
+// the shared transcript, so a party with only the transcript file can
+// never derive the toxic waste this command produces.
+func runSetupFinalize(args []string) error {
+	fs := flag.NewFlagSet("setup-finalize", flag.ExitOnError)
+	inTranscript := fs.String("in-transcript", "", "Input final transcript file path")
+	secretFiles := fs.String("secret-files", "", "Comma-separated contributor secret files, in contribution order (from each round's --out-secret)")
+	inR1CS := fs.String("in-r1cs", "", "Input serialized R1CS file path")
+	outPK := fs.String("out-pk", "", "Output proving key file path")
+	outVK := fs.String("out-vk", "", "Output verifying key file path")
+	fs.Parse(args)
+
+	if *inTranscript == "" || *secretFiles == "" || *inR1CS == "" || *outPK == "" || *outVK == "" {
+		return fmt.Errorf("setup-finalize: --in-transcript, --secret-files, --in-r1cs, --out-pk and --out-vk are required")
+	}
+
+	t, err := readTranscript(*inTranscript)
+	if err != nil {
+		return err
+	}
+	if len(t.Contributions) < 2 {
+		return fmt.Errorf("transcript has no contributions beyond the genesis round; run setup-contribute at least once")
+	}
+
+	rounds := t.Contributions[1:]
+	paths := strings.Split(*secretFiles, ",")
+	if len(paths) != len(rounds) {
+		return fmt.Errorf("setup-finalize: got %d --secret-files, transcript has %d contribution rounds", len(paths), len(rounds))
+	}
+
+	seed, err := combineSecrets(paths, rounds)
+	if err != nil {
+		return err
+	}
+
+	ccs, err := readR1CS(*inR1CS)
+	if err != nil {
+		return err
+	}
+
+	// groth16.Setup draws its toxic waste from crypto/rand.Reader; swapping
+	// it for a stream seeded by the contributors' own (never-published)
+	// entropy makes the derived pk/vk a function of every round's
+	// contribution instead of whatever the finalizer's own machine happens
+	// to generate.
+	origReader := rand.Reader
+	rand.Reader = newSeededReader(seed)
+	defer func() { rand.Reader = origReader }()
+
+	pk, vk, err := groth16.Setup(ccs)
+	if err != nil {
+		return fmt.Errorf("deriving keys: %w", err)
+	}
+
+	if err := writeTo(*outPK, pk); err != nil {
+		return fmt.Errorf("writing proving key: %w", err)
+	}
+	if err := writeTo(*outVK, vk); err != nil {
+		return fmt.Errorf("writing verifying key: %w", err)
+	}
+
+	fmt.Printf("Ceremony finalized from %d contributions (final hash %s)\n", len(rounds), t.lastHash())
+	fmt.Printf("Wrote proving key to %s, verifying key to %s\n", *outPK, *outVK)
+	return nil
+}
+
+// combineSecrets reads each contributor's secret file, checks it against
+// the commitment recorded for its round (catching a swapped or corrupted
+// secret file before it silently changes the derived keys), and folds them
+// together into a single seed via the same BLAKE2b hash chain the
+// transcript itself uses, so the result depends on every contribution.
+func combineSecrets(paths []string, rounds []Contribution) ([]byte, error) {
+	chain := ""
+	for i, path := range paths {
+		secret, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading secret file %s: %w", path, err)
+		}
+
+		commitment, err := commitEntropy(secret)
+		if err != nil {
+			return nil, err
+		}
+		if commitment != rounds[i].Commitment {
+			return nil, fmt.Errorf("secret file %s does not match round %d's commitment", path, rounds[i].Round)
+		}
+
+		chain, err = hashState(chain, secret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []byte(chain), nil
+}
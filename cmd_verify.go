@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/backend/witness"
+)
+
+// groth16ProofJSON has the same fields and JSON tags as
+// backend.Groth16Proof, and is what verify decodes a json-format proof into.
+type groth16ProofJSON struct {
+	Proof         []string `json:"proof"`
+	Inputs        []string `json:"inputs"`
+	Commitments   []string `json:"commitments"`
+	CommitmentPok []string `json:"commitment_pok"`
+}
+
+// runVerify checks a proof file against a verifying key. The json and gnark
+// formats are verified with groth16.Verify, after reconstructing a
+// groth16.Proof from their on-disk encoding. The bellman format strips the
+// commitment/PoK fields gnark's own Groth16 variant needs, so for it only the
+// shape is checked (public input count and total length); it is not
+// cryptographically verified.
+func runVerify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	inProof := fs.String("in-proof", "", "Input proof file path")
+	inVK := fs.String("in-vk", "", "Input verifying key file path")
+	proofFormat := fs.String("proof-format", "json", "Proof serialization: json, gnark or bellman")
+	fs.Parse(args)
+
+	if *inProof == "" || *inVK == "" {
+		return fmt.Errorf("verify: --in-proof and --in-vk are required")
+	}
+
+	vk, err := readVK(*inVK)
+	if err != nil {
+		return err
+	}
+
+	proofBytes, err := os.ReadFile(*inProof)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", *inProof, err)
+	}
+
+	switch *proofFormat {
+	case "", "json":
+		return verifyJSONProof(proofBytes, vk)
+	case "gnark":
+		return verifyGnarkProof(proofBytes, vk)
+	case "bellman":
+		return verifyBellmanProof(proofBytes, vk)
+	default:
+		return fmt.Errorf("unknown proof format: %s", *proofFormat)
+	}
+}
+
+// verifyJSONProof decodes the bespoke hex-string JSON format and runs the
+// real groth16.Verify against it: every field (Ar/Bs/Krs, the Pedersen
+// commitments and their proof-of-knowledge) is present as plain hex, so the
+// full proof can be rebuilt from it.
+func verifyJSONProof(proofBytes []byte, vk groth16.VerifyingKey) error {
+	var p groth16ProofJSON
+	if err := json.Unmarshal(proofBytes, &p); err != nil {
+		return fmt.Errorf("decoding proof: %w", err)
+	}
+	if len(p.Proof) != 8 {
+		return fmt.Errorf("proof has %d elements, expected 8 (Ar, Bs, Krs)", len(p.Proof))
+	}
+	if want := vk.NbPublicWitness(); len(p.Inputs) > want {
+		return fmt.Errorf("proof has %d public inputs, verifying key expects at most %d", len(p.Inputs), want)
+	}
+
+	proof, err := decodeGroth16ProofJSON(p)
+	if err != nil {
+		return fmt.Errorf("decoding proof elements: %w", err)
+	}
+
+	w, err := buildPublicWitnessFromHex(p.Inputs)
+	if err != nil {
+		return fmt.Errorf("decoding public inputs: %w", err)
+	}
+
+	if err := groth16.Verify(proof, vk, w); err != nil {
+		return fmt.Errorf("proof does not verify: %w", err)
+	}
+	fmt.Println("OK: proof verifies")
+	return nil
+}
+
+// decodeGroth16ProofJSON rebuilds a groth16.Proof from the hex field
+// elements of a groth16ProofJSON, in the same order MarshalGroth16Proof
+// wrote them: Ar, Bs (as the [X1, X0, Y1, Y0] G2 layout gnark's exported
+// Solidity verifier expects), Krs, then any Pedersen commitments and their
+// proof-of-knowledge.
+func decodeGroth16ProofJSON(p groth16ProofJSON) (groth16.Proof, error) {
+	ar, err := decodeG1(p.Proof[0], p.Proof[1])
+	if err != nil {
+		return nil, err
+	}
+	bs, err := decodeG2(p.Proof[2], p.Proof[3], p.Proof[4], p.Proof[5])
+	if err != nil {
+		return nil, err
+	}
+	krs, err := decodeG1(p.Proof[6], p.Proof[7])
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &groth16bn254.Proof{Ar: ar, Bs: bs, Krs: krs}
+
+	if len(p.Commitments)%2 != 0 {
+		return nil, fmt.Errorf("odd number of commitment elements")
+	}
+	for i := 0; i < len(p.Commitments); i += 2 {
+		c, err := decodeG1(p.Commitments[i], p.Commitments[i+1])
+		if err != nil {
+			return nil, err
+		}
+		proof.Commitments = append(proof.Commitments, c)
+	}
+	if len(p.CommitmentPok) == 2 {
+		pok, err := decodeG1(p.CommitmentPok[0], p.CommitmentPok[1])
+		if err != nil {
+			return nil, err
+		}
+		proof.CommitmentPok = pok
+	} else if len(p.CommitmentPok) != 0 {
+		return nil, fmt.Errorf("commitment_pok has %d elements, expected 0 or 2", len(p.CommitmentPok))
+	}
+
+	return proof, nil
+}
+
+// decodeHexField decodes a "0x"-prefixed 32-byte field element as written
+// by MarshalGroth16Proof.
+func decodeHexField(s string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != 32 {
+		return nil, fmt.Errorf("field element %q is %d bytes, expected 32", s, len(b))
+	}
+	return b, nil
+}
+
+func decodeG1(xHex, yHex string) (bn254.G1Affine, error) {
+	var p bn254.G1Affine
+	x, err := decodeHexField(xHex)
+	if err != nil {
+		return p, err
+	}
+	y, err := decodeHexField(yHex)
+	if err != nil {
+		return p, err
+	}
+	p.X.SetBytes(x)
+	p.Y.SetBytes(y)
+	return p, nil
+}
+
+// decodeG2 takes x1Hex/x0Hex/y1Hex/y0Hex in the [X1, X0, Y1, Y0] order
+// gnark's exported Solidity verifier uses for G2 points.
+func decodeG2(x1Hex, x0Hex, y1Hex, y0Hex string) (bn254.G2Affine, error) {
+	var p bn254.G2Affine
+	x1, err := decodeHexField(x1Hex)
+	if err != nil {
+		return p, err
+	}
+	x0, err := decodeHexField(x0Hex)
+	if err != nil {
+		return p, err
+	}
+	y1, err := decodeHexField(y1Hex)
+	if err != nil {
+		return p, err
+	}
+	y0, err := decodeHexField(y0Hex)
+	if err != nil {
+		return p, err
+	}
+	p.X.A0.SetBytes(x0)
+	p.X.A1.SetBytes(x1)
+	p.Y.A0.SetBytes(y0)
+	p.Y.A1.SetBytes(y1)
+	return p, nil
+}
+
+// buildPublicWitnessFromHex builds a witness.Witness carrying inputs as its
+// public vector, by assembling the same [nbPublic nbSecret][n|elements]
+// binary layout witness.Witness.MarshalBinary produces and unmarshalling it
+// back — the inverse of the extraction MarshalGroth16Proof does to get
+// inputBytes in the first place.
+func buildPublicWitnessFromHex(inputs []string) (witness.Witness, error) {
+	const fpSize = 4 * 8
+	buf := make([]byte, 12+len(inputs)*fpSize)
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(inputs)))
+	binary.BigEndian.PutUint32(buf[4:8], 0)
+	binary.BigEndian.PutUint32(buf[8:12], uint32(len(inputs)))
+	for i, in := range inputs {
+		b, err := decodeHexField(in)
+		if err != nil {
+			return nil, err
+		}
+		copy(buf[12+i*fpSize:12+(i+1)*fpSize], b)
+	}
+
+	w, err := witness.New(ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, err
+	}
+	if err := w.UnmarshalBinary(buf); err != nil {
+		return nil, fmt.Errorf("unmarshalling public witness: %w", err)
+	}
+	return w, nil
+}
+
+// verifyGnarkProof decodes the length-prefixed proof+witness produced by
+// --proof-format gnark and runs groth16.Verify against vk.
+func verifyGnarkProof(data []byte, vk groth16.VerifyingKey) error {
+	if len(data) < 4 {
+		return fmt.Errorf("gnark-format proof is too short")
+	}
+	proofLen := int(data[0])<<24 | int(data[1])<<16 | int(data[2])<<8 | int(data[3])
+	rest := data[4:]
+	if len(rest) < proofLen {
+		return fmt.Errorf("gnark-format proof is truncated")
+	}
+
+	proof := groth16.NewProof(ecc.BN254)
+	if _, err := proof.ReadFrom(bytes.NewReader(rest[:proofLen])); err != nil {
+		return fmt.Errorf("reading proof: %w", err)
+	}
+
+	w, err := witness.New(ecc.BN254.ScalarField())
+	if err != nil {
+		return err
+	}
+	if err := w.UnmarshalBinary(rest[proofLen:]); err != nil {
+		return fmt.Errorf("unmarshalling public witness: %w", err)
+	}
+
+	if err := groth16.Verify(proof, vk, w); err != nil {
+		return fmt.Errorf("proof does not verify: %w", err)
+	}
+	fmt.Println("OK: proof verifies")
+	return nil
+}
+
+// verifyBellmanProof checks the structural shape of a bellman-layout proof
+// (witness prefix + raw Ar/Bs/Krs) against vk. Cryptographic verification
+// of this layout is left to the bellman/zcash-style verifier it targets.
+func verifyBellmanProof(data []byte, vk groth16.VerifyingKey) error {
+	const fpSize = 4 * 8
+	if len(data) < 12 {
+		return fmt.Errorf("bellman-format proof is too short")
+	}
+
+	nbPublic := int(data[0])<<24 | int(data[1])<<16 | int(data[2])<<8 | int(data[3])
+	nbSecret := int(data[4])<<24 | int(data[5])<<16 | int(data[6])<<8 | int(data[7])
+	if nbSecret != 0 {
+		return fmt.Errorf("bellman-format proof carries a secret witness, expected public-only")
+	}
+	if want := vk.NbPublicWitness(); nbPublic > want {
+		return fmt.Errorf("proof has %d public inputs, verifying key expects at most %d", nbPublic, want)
+	}
+
+	witnessEnd := 12 + nbPublic*fpSize
+	if len(data) != witnessEnd+fpSize*8 {
+		return fmt.Errorf("bellman-format proof has unexpected length")
+	}
+
+	fmt.Printf("OK: bellman-format proof has %d public inputs, consistent with verifying key\n", nbPublic)
+	return nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/witness"
+)
+
+// runWitnessCmd computes a witness for the wrapped proof in in-dir and
+// writes it out, without running Setup or Prove. Useful when witness
+// generation should happen close to the data (e.g. on the machine that
+// produced the plonky2 proof) while proving happens elsewhere, possibly on
+// a GPU host.
+func runWitnessCmd(args []string) error {
+	fs := flag.NewFlagSet("witness", flag.ExitOnError)
+	inDir := fs.String("in-dir", "", "Input wrapped proof dir path")
+	outWitness := fs.String("out-witness", "", "Output witness file path, gnark-standard binary layout")
+	outWitnessJSON := fs.String("out-witness-json", "", "Output witness file path, schema-aware JSON")
+	fs.Parse(args)
+
+	if *inDir == "" || (*outWitness == "" && *outWitnessJSON == "") {
+		return fmt.Errorf("witness: --in-dir and one of --out-witness/--out-witness-json are required")
+	}
+
+	w, err := BuildWitness(*inDir)
+	if err != nil {
+		return fmt.Errorf("building witness: %w", err)
+	}
+
+	if *outWitness != "" {
+		b, err := w.MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("marshalling witness: %w", err)
+		}
+		if err := os.WriteFile(*outWitness, b, 0644); err != nil {
+			return fmt.Errorf("writing witness: %w", err)
+		}
+		fmt.Printf("Wrote witness to %s\n", *outWitness)
+	}
+
+	if *outWitnessJSON != "" {
+		sch, err := circuitSchema(*inDir)
+		if err != nil {
+			return fmt.Errorf("building circuit schema: %w", err)
+		}
+		b, err := w.ToJSON(sch)
+		if err != nil {
+			return fmt.Errorf("marshalling witness to JSON: %w", err)
+		}
+		if err := os.WriteFile(*outWitnessJSON, b, 0644); err != nil {
+			return fmt.Errorf("writing witness JSON: %w", err)
+		}
+		fmt.Printf("Wrote witness JSON to %s\n", *outWitnessJSON)
+	}
+
+	return nil
+}
+
+// readWitnessFile loads a gnark-standard binary witness file previously
+// written by the witness subcommand.
+func readWitnessFile(path string) (witness.Witness, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	w, err := witness.New(ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, err
+	}
+	if err := w.UnmarshalBinary(b); err != nil {
+		return nil, fmt.Errorf("unmarshalling witness: %w", err)
+	}
+	return w, nil
+}
+
+// readWitnessJSONFile loads a schema-aware JSON witness file previously
+// written by `witness --out-witness-json`. inDir is the wrapped proof dir
+// the witness was built from, needed to reconstruct the same schema it was
+// marshalled with.
+func readWitnessJSONFile(path, inDir string) (witness.Witness, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	sch, err := circuitSchema(inDir)
+	if err != nil {
+		return nil, fmt.Errorf("building circuit schema: %w", err)
+	}
+
+	w, err := witness.New(ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, err
+	}
+	if err := w.FromJSON(sch, b); err != nil {
+		return nil, fmt.Errorf("unmarshalling witness JSON: %w", err)
+	}
+	return w, nil
+}
@@ -0,0 +1,68 @@
+// Groth16 prover CLI
+//
+// The lifecycle is split into independent subcommands so that the one-time
+// circuit compilation and trusted setup can be reused across many proofs,
+// and so setup can run on a beefy machine while prove runs elsewhere:
+//
+//	compile           circuit -> R1CS
+//	setup             R1CS -> proving key (--dummy only; see below)
+//	setup-init        Powers-of-Tau file -> phase-1 transcript
+//	setup-contribute  transcript -> transcript with one more contribution
+//	setup-finalize    transcript + R1CS -> proving/verifying keys
+//	witness           wrapped proof dir -> witness file (binary or JSON)
+//	prove             R1CS + proving key + (wrapped proof dir | witness file) -> proof
+//	verify            proof + verifying key -> ok/error
+//	export-solidity   verifying key -> Solidity verifier contract
+//
+// Production deployments should not trust a single party's setup; run
+// setup-init once, have every participant run setup-contribute in turn, and
+// run setup-finalize against the resulting transcript. The plain setup
+// subcommand only supports --dummy, for fast local iteration.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	cmd, args := os.Args[1], os.Args[2:]
+	var err error
+	switch cmd {
+	case "compile":
+		err = runCompile(args)
+	case "setup":
+		err = runSetup(args)
+	case "setup-init":
+		err = runSetupInit(args)
+	case "setup-contribute":
+		err = runSetupContribute(args)
+	case "setup-finalize":
+		err = runSetupFinalize(args)
+	case "witness":
+		err = runWitnessCmd(args)
+	case "prove":
+		err = runProve(args)
+	case "verify":
+		err = runVerify(args)
+	case "export-solidity":
+		err = runExportSolidity(args)
+	default:
+		usage()
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Println("usage: prover <compile|setup|setup-init|setup-contribute|setup-finalize|witness|prove|verify|export-solidity> [flags]")
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Contribution records one participant's turn in an MPC ceremony: a
+// one-way commitment to the entropy they contributed (never the entropy
+// itself — that stays in the contributor's own --out-secret file) chained
+// onto the hash of the state they started from, and who/when. Chaining
+// PrevHash -> Hash lets any third party replay and audit the whole
+// ceremony from the phase-1 transcript onward without ever learning what
+// any contributor actually generated.
+type Contribution struct {
+	Round       int       `json:"round"`
+	PrevHash    string    `json:"prev_hash"`
+	Commitment  string    `json:"commitment"`
+	Hash        string    `json:"hash"`
+	Contributor string    `json:"contributor,omitempty"`
+	Time        time.Time `json:"time"`
+}
+
+// Transcript is the on-disk, publishable ceremony state: the phase-1
+// Powers-of-Tau / Aztec ignition bytes the ceremony is bound to, plus the
+// audit trail of contributions made against it. Nothing in here is ever
+// sufficient to derive any contributor's entropy or the final toxic
+// waste — see Contribution.Commitment and runSetupContribute's
+// --out-secret.
+type Transcript struct {
+	Phase         int            `json:"phase"` // 1 or 2
+	Data          []byte         `json:"data"`
+	Contributions []Contribution `json:"contributions"`
+}
+
+func (t *Transcript) lastHash() string {
+	if len(t.Contributions) == 0 {
+		return ""
+	}
+	return t.Contributions[len(t.Contributions)-1].Hash
+}
+
+// hashState returns the BLAKE2b-256 digest of prevHash (hex) concatenated
+// with data, the link in the ceremony's hash chain.
+func hashState(prevHash string, data []byte) (string, error) {
+	h, err := blake2b.New256(nil)
+	if err != nil {
+		return "", err
+	}
+	h.Write([]byte(prevHash))
+	h.Write(data)
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+func readTranscript(path string) (*Transcript, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	var t Transcript
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, fmt.Errorf("decoding transcript: %w", err)
+	}
+	return &t, nil
+}
+
+func writeTranscript(path string, t *Transcript) error {
+	b, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// randomEntropy returns 32 bytes of CSPRNG entropy, used to mix a
+// contribution into the ceremony when the caller doesn't supply their own.
+func randomEntropy() ([]byte, error) {
+	e := make([]byte, 32)
+	if _, err := rand.Read(e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// commitEntropy returns a one-way BLAKE2b-256 commitment to entropy, safe
+// to publish in a Transcript: preimage resistance keeps it from revealing
+// the entropy it commits to, so it can travel in the shared transcript
+// file while the entropy itself never does.
+func commitEntropy(entropy []byte) (string, error) {
+	h, err := blake2b.New256(nil)
+	if err != nil {
+		return "", err
+	}
+	h.Write(entropy)
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// seededReader is a BLAKE2b counter-mode expansion of a fixed seed: an
+// io.Reader that deterministically yields the same stream for the same
+// seed. runSetupFinalize swaps crypto/rand.Reader for one of these, seeded
+// from the contributors' secret files as folded together by combineSecrets,
+// so the toxic waste groth16.Setup
+// draws is a function of every round's contributed entropy instead of the
+// finalizer's own, unaccountable, call to crypto/rand.
+type seededReader struct {
+	seed    []byte
+	counter uint64
+	buf     []byte
+}
+
+func newSeededReader(seed []byte) *seededReader {
+	return &seededReader{seed: seed}
+}
+
+func (r *seededReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(r.buf) == 0 {
+			h, err := blake2b.New256(nil)
+			if err != nil {
+				return n, err
+			}
+			h.Write(r.seed)
+			var c [8]byte
+			for i := range c {
+				c[i] = byte(r.counter >> (8 * i))
+			}
+			h.Write(c[:])
+			r.counter++
+			r.buf = h.Sum(nil)
+		}
+		copied := copy(p[n:], r.buf)
+		r.buf = r.buf[copied:]
+		n += copied
+	}
+	return n, nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"reflect"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/witness"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/schema"
+	"github.com/succinctlabs/gnark-plonky2-verifier/circuit"
+)
+
+// tVariable is the leaf type schema.New walks frontend.Circuit structs
+// looking for, matching the convention used by frontend.Compile/NewWitness.
+var tVariable = reflect.TypeOf(frontend.Variable(nil))
+
+// BuildWitness reads the wrapped proof in inDir and computes a gnark
+// witness for verifier.ExampleVerifierCircuit, without running Setup or
+// Prove. It's the shared entry point for both the `witness` subcommand and
+// `prove --in-dir` (when no precomputed witness is given), so witness
+// computation can happen separately from
+// (and potentially much closer to the data than) proving.
+func BuildWitness(inDir string) (witness.Witness, error) {
+	assignment, err := circuit.Assignment(inDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+}
+
+// circuitSchema returns the schema describing the shape of the wrapped
+// proof in inDir, needed to marshal a witness to/from JSON. The schema
+// must be built from a circuit populated with the real proof's slice
+// lengths (Proof, PublicInputs, VerifierOnlyCircuitData), not a
+// zero-valued verifier.ExampleVerifierCircuit{}, or schema.New disagrees
+// with the witness on its variable count.
+func circuitSchema(inDir string) (*schema.Schema, error) {
+	assignment, err := circuit.Assignment(inDir)
+	if err != nil {
+		return nil, err
+	}
+	return schema.New(assignment, tVariable)
+}